@@ -0,0 +1,53 @@
+// Copyright 2025 The NLP Odyssey Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agents
+
+import (
+	"context"
+
+	"github.com/nlpodyssey/openai-agents-go/modelsettings"
+)
+
+// An Agent is an AI model configured with instructions, tools, and other run-time
+// behavior.
+type Agent struct {
+	// The name of the agent.
+	Name string
+
+	// A list of tools that the agent can use.
+	Tools []Tool
+
+	// Configures model-specific tuning parameters (e.g. temperature, top_p).
+	ModelSettings modelsettings.ModelSettings
+
+	// ToolConfig constrains how the agent selects among Tools on a given turn, on top
+	// of each Tool's own IsEnabled. The run loop consults it every turn, via
+	// GetAllTools and NextToolChoice. Defaults to the zero value, i.e. ToolChoiceAuto
+	// with no restriction on which tools are exposed.
+	ToolConfig ToolConfig
+}
+
+// GetAllTools returns the tools exposed to the model for the next turn, after
+// applying ToolConfig.AllowedToolNames via FilterAllowedTools.
+func (a *Agent) GetAllTools(context.Context) ([]Tool, error) {
+	return FilterAllowedTools(a.ToolConfig, a.Tools), nil
+}
+
+// NextToolChoice resolves the modelsettings.ToolChoice to send to the model for the
+// next turn, via ResolveToolChoice. toolCallsSoFar is the number of tool calls the
+// run has made so far across every turn, for ToolConfig.MaxToolCalls enforcement.
+func (a *Agent) NextToolChoice(toolCallsSoFar int) modelsettings.ToolChoice {
+	return ResolveToolChoice(a.ToolConfig, toolCallsSoFar)
+}
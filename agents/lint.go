@@ -0,0 +1,251 @@
+// Copyright 2025 The NLP Odyssey Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agents
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// LintSeverity classifies a LintIssue.
+type LintSeverity string
+
+const (
+	// LintError marks an issue that will cause OpenAI's strict mode to reject the
+	// tool, or EnsureStrictJSONSchema to silently rewrite it.
+	LintError LintSeverity = "error"
+
+	// LintWarning marks an issue that won't break strict mode, but is likely to
+	// confuse the model (e.g. a parameter with no description).
+	LintWarning LintSeverity = "warning"
+)
+
+// LintIssue is a single problem found by LintTools.
+type LintIssue struct {
+	// ToolName is the offending tool's Name, or "" if the tool itself has no name.
+	ToolName string
+
+	// Path locates the issue within the tool's ParamsJSONSchema, e.g. "city" or
+	// "items[].sku". Empty for issues about the tool itself (name, description).
+	Path string
+
+	Severity LintSeverity
+	Message  string
+}
+
+func (i LintIssue) String() string {
+	if i.Path == "" {
+		return fmt.Sprintf("[%s] %s: %s", i.Severity, i.ToolName, i.Message)
+	}
+	return fmt.Sprintf("[%s] %s (%s): %s", i.Severity, i.ToolName, i.Path, i.Message)
+}
+
+// maxToolNameLength mirrors OpenAI's limit on function tool names.
+const maxToolNameLength = 64
+
+var toolNamePattern = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+// LintTools walks tools and reports everything that would cause OpenAI's strict
+// function-calling mode to reject them outright, or to rewrite them (via
+// EnsureStrictJSONSchema) in a way the author probably didn't intend. Run it in CI,
+// via cmd/agents-lint, before shipping a new or changed tool to a model.
+func LintTools(tools []FunctionTool) []LintIssue {
+	var issues []LintIssue
+	seenNames := map[string]bool{}
+
+	for _, tool := range tools {
+		switch {
+		case tool.Name == "":
+			issues = append(issues, LintIssue{Severity: LintError, Message: "tool has no name"})
+		case seenNames[tool.Name]:
+			issues = append(issues, LintIssue{ToolName: tool.Name, Severity: LintError, Message: "duplicate tool name"})
+		default:
+			seenNames[tool.Name] = true
+		}
+
+		if len(tool.Name) > maxToolNameLength {
+			issues = append(issues, LintIssue{ToolName: tool.Name, Severity: LintError, Message: fmt.Sprintf("name exceeds OpenAI's %d-character limit", maxToolNameLength)})
+		}
+		if tool.Name != "" && !toolNamePattern.MatchString(tool.Name) {
+			issues = append(issues, LintIssue{ToolName: tool.Name, Severity: LintError, Message: "name contains characters outside [a-zA-Z0-9_-]"})
+		}
+		if tool.Description == "" {
+			issues = append(issues, LintIssue{ToolName: tool.Name, Severity: LintWarning, Message: "tool has no description"})
+		}
+
+		issues = append(issues, lintParamsSchema(tool.Name, "", tool.ParamsJSONSchema, tool.ParamsJSONSchema, nil)...)
+	}
+
+	sort.SliceStable(issues, func(i, j int) bool { return issues[i].ToolName < issues[j].ToolName })
+	return issues
+}
+
+// lintParamsSchema recursively checks a (sub-)schema for strict-mode conformance.
+// root is the tool's whole ParamsJSONSchema, used to resolve local "#/..." $refs.
+// visitedRefs tracks $refs already resolved on the current path, to detect cycles.
+func lintParamsSchema(toolName, path string, root, schema map[string]any, visitedRefs map[string]bool) []LintIssue {
+	if schema == nil {
+		return nil
+	}
+
+	var issues []LintIssue
+
+	if ref, ok := schema["$ref"].(string); ok {
+		if visitedRefs[ref] {
+			return []LintIssue{{ToolName: toolName, Path: displayPath(path), Severity: LintError, Message: fmt.Sprintf("$ref cycle through %q", ref)}}
+		}
+		resolved, err := resolveLocalJSONPointer(root, ref)
+		if err != nil {
+			return []LintIssue{{ToolName: toolName, Path: displayPath(path), Severity: LintWarning, Message: err.Error()}}
+		}
+		return lintParamsSchema(toolName, path, root, resolved, withRefName(visitedRefs, ref))
+	}
+
+	if _, hasProperties := schema["properties"]; hasProperties || schema["type"] == "object" {
+		additionalProperties, hasAdditionalProperties := schema["additionalProperties"]
+		if !hasAdditionalProperties || additionalProperties != false {
+			issues = append(issues, LintIssue{ToolName: toolName, Path: displayPath(path), Severity: LintError, Message: `object schema is missing "additionalProperties": false`})
+		}
+
+		properties, _ := schema["properties"].(map[string]any)
+		required := map[string]bool{}
+		for _, r := range stringsOf(schema["required"]) {
+			required[r] = true
+		}
+
+		for _, name := range sortedKeys(properties) {
+			if !required[name] {
+				issues = append(issues, LintIssue{ToolName: toolName, Path: displayPath(path), Severity: LintError, Message: fmt.Sprintf("property %q is not listed in \"required\"", name)})
+			}
+
+			propSchema, _ := properties[name].(map[string]any)
+			if _, hasDescription := propSchema["description"]; !hasDescription {
+				issues = append(issues, LintIssue{ToolName: toolName, Path: displayPath(path), Severity: LintWarning, Message: fmt.Sprintf("property %q has no description", name)})
+			}
+
+			childPath := name
+			if path != "" {
+				childPath = path + "." + name
+			}
+			issues = append(issues, lintParamsSchema(toolName, childPath, root, propSchema, visitedRefs)...)
+		}
+	}
+
+	if _, ok := schema["patternProperties"]; ok {
+		issues = append(issues, LintIssue{ToolName: toolName, Path: displayPath(path), Severity: LintError, Message: `"patternProperties" is not supported in strict mode`})
+	}
+	if format, _ := schema["format"].(string); format == "date" {
+		issues = append(issues, LintIssue{ToolName: toolName, Path: displayPath(path), Severity: LintWarning, Message: `"format": "date" is not a JSON Schema format OpenAI validates; consider a pattern or description instead`})
+	}
+
+	if enumValues, ok := schema["enum"].([]any); ok {
+		declaredType, _ := schema["type"].(string)
+		for _, v := range enumValues {
+			if !enumValueMatchesType(v, declaredType) {
+				issues = append(issues, LintIssue{ToolName: toolName, Path: displayPath(path), Severity: LintError, Message: fmt.Sprintf("enum value %v doesn't match declared type %q", v, declaredType)})
+			}
+		}
+	}
+
+	if items, ok := schema["items"].(map[string]any); ok {
+		itemsPath := path + "[]"
+		issues = append(issues, lintParamsSchema(toolName, itemsPath, root, items, visitedRefs)...)
+	}
+
+	return issues
+}
+
+func displayPath(path string) string {
+	if path == "" {
+		return "(root)"
+	}
+	return path
+}
+
+func stringsOf(v any) []string {
+	items, _ := v.([]any)
+	out := make([]string, 0, len(items))
+	for _, item := range items {
+		if s, ok := item.(string); ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func withRefName(visited map[string]bool, ref string) map[string]bool {
+	next := make(map[string]bool, len(visited)+1)
+	for k := range visited {
+		next[k] = true
+	}
+	next[ref] = true
+	return next
+}
+
+// resolveLocalJSONPointer follows a local "#/a/b/c" JSON Pointer against root. Refs
+// pointing outside the tool's own schema aren't supported, since linting only ever
+// sees one tool's ParamsJSONSchema in isolation.
+func resolveLocalJSONPointer(root map[string]any, ref string) (map[string]any, error) {
+	if !strings.HasPrefix(ref, "#/") {
+		return nil, fmt.Errorf("unsupported non-local $ref %q", ref)
+	}
+
+	var cur any = root
+	for _, segment := range strings.Split(ref[2:], "/") {
+		segment = strings.ReplaceAll(strings.ReplaceAll(segment, "~1", "/"), "~0", "~")
+		m, ok := cur.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("$ref %q: cannot descend into %T", ref, cur)
+		}
+		v, ok := m[segment]
+		if !ok {
+			return nil, fmt.Errorf("$ref %q: no such key %q", ref, segment)
+		}
+		cur = v
+	}
+
+	resolved, ok := cur.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("$ref %q does not resolve to an object", ref)
+	}
+	return resolved, nil
+}
+
+func enumValueMatchesType(v any, declaredType string) bool {
+	switch declaredType {
+	case "", "string":
+		_, ok := v.(string)
+		return ok || declaredType == ""
+	case "number", "integer":
+		_, ok := v.(float64)
+		return ok
+	case "boolean":
+		_, ok := v.(bool)
+		return ok
+	default:
+		return true
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright 2025 The NLP Odyssey Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agents_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wuhei/openai-agents-go/agents"
+)
+
+func hasIssue(issues []agents.LintIssue, severity agents.LintSeverity, substr string) bool {
+	for _, issue := range issues {
+		if issue.Severity == severity && strings.Contains(issue.Message, substr) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestLintTools(t *testing.T) {
+	t.Run("a clean strict-mode tool produces no issues", func(t *testing.T) {
+		issues := agents.LintTools([]agents.FunctionTool{{
+			Name:        "get_weather",
+			Description: "Get the current weather for a city.",
+			ParamsJSONSchema: map[string]any{
+				"type":                 "object",
+				"additionalProperties": false,
+				"required":             []any{"city"},
+				"properties": map[string]any{
+					"city": map[string]any{"type": "string", "description": "City name."},
+				},
+			},
+		}})
+		assert.Empty(t, issues)
+	})
+
+	t.Run("flags a tool with no name", func(t *testing.T) {
+		issues := agents.LintTools([]agents.FunctionTool{{}})
+		assert.True(t, hasIssue(issues, agents.LintError, "no name"))
+	})
+
+	t.Run("flags duplicate tool names", func(t *testing.T) {
+		issues := agents.LintTools([]agents.FunctionTool{
+			{Name: "get_weather", Description: "d", ParamsJSONSchema: map[string]any{}},
+			{Name: "get_weather", Description: "d", ParamsJSONSchema: map[string]any{}},
+		})
+		assert.True(t, hasIssue(issues, agents.LintError, "duplicate tool name"))
+	})
+
+	t.Run("flags names over the 64-char limit and outside [a-zA-Z0-9_-]", func(t *testing.T) {
+		issues := agents.LintTools([]agents.FunctionTool{{
+			Name:             strings.Repeat("a", 65),
+			Description:      "d",
+			ParamsJSONSchema: map[string]any{},
+		}})
+		assert.True(t, hasIssue(issues, agents.LintError, "64-character limit"))
+
+		issues = agents.LintTools([]agents.FunctionTool{{
+			Name:             "get weather!",
+			Description:      "d",
+			ParamsJSONSchema: map[string]any{},
+		}})
+		assert.True(t, hasIssue(issues, agents.LintError, "outside [a-zA-Z0-9_-]"))
+	})
+
+	t.Run("flags a missing description as a warning", func(t *testing.T) {
+		issues := agents.LintTools([]agents.FunctionTool{{
+			Name:             "get_weather",
+			ParamsJSONSchema: map[string]any{},
+		}})
+		assert.True(t, hasIssue(issues, agents.LintWarning, "no description"))
+	})
+
+	t.Run("flags a missing additionalProperties:false on object schemas", func(t *testing.T) {
+		issues := agents.LintTools([]agents.FunctionTool{{
+			Name:        "get_weather",
+			Description: "d",
+			ParamsJSONSchema: map[string]any{
+				"type":       "object",
+				"properties": map[string]any{},
+			},
+		}})
+		assert.True(t, hasIssue(issues, agents.LintError, `"additionalProperties": false`))
+	})
+
+	t.Run("flags a property missing from required", func(t *testing.T) {
+		issues := agents.LintTools([]agents.FunctionTool{{
+			Name:        "get_weather",
+			Description: "d",
+			ParamsJSONSchema: map[string]any{
+				"type":                 "object",
+				"additionalProperties": false,
+				"properties": map[string]any{
+					"city": map[string]any{"type": "string", "description": "City."},
+				},
+			},
+		}})
+		assert.True(t, hasIssue(issues, agents.LintError, `not listed in "required"`))
+	})
+
+	t.Run("flags patternProperties and format:date", func(t *testing.T) {
+		issues := agents.LintTools([]agents.FunctionTool{{
+			Name:        "get_weather",
+			Description: "d",
+			ParamsJSONSchema: map[string]any{
+				"type":                 "object",
+				"additionalProperties": false,
+				"patternProperties":    map[string]any{"^x-": map[string]any{"type": "string"}},
+				"properties": map[string]any{
+					"day": map[string]any{"type": "string", "format": "date", "description": "Day."},
+				},
+				"required": []any{"day"},
+			},
+		}})
+		assert.True(t, hasIssue(issues, agents.LintError, "patternProperties"))
+		assert.True(t, hasIssue(issues, agents.LintWarning, `"format": "date"`))
+	})
+
+	t.Run("flags an enum value that doesn't match the declared type", func(t *testing.T) {
+		issues := agents.LintTools([]agents.FunctionTool{{
+			Name:        "get_weather",
+			Description: "d",
+			ParamsJSONSchema: map[string]any{
+				"type":                 "object",
+				"additionalProperties": false,
+				"required":             []any{"units"},
+				"properties": map[string]any{
+					"units": map[string]any{
+						"type":        "string",
+						"description": "Units.",
+						"enum":        []any{"celsius", 1},
+					},
+				},
+			},
+		}})
+		assert.True(t, hasIssue(issues, agents.LintError, "doesn't match declared type"))
+	})
+
+	t.Run("flags an unresolvable $ref", func(t *testing.T) {
+		issues := agents.LintTools([]agents.FunctionTool{{
+			Name:        "get_weather",
+			Description: "d",
+			ParamsJSONSchema: map[string]any{
+				"type":                 "object",
+				"additionalProperties": false,
+				"required":             []any{"city"},
+				"properties": map[string]any{
+					"city": map[string]any{"$ref": "#/does/not/exist"},
+				},
+			},
+		}})
+		assert.True(t, hasIssue(issues, agents.LintWarning, "no such key"))
+	})
+}
@@ -0,0 +1,90 @@
+// Copyright 2025 The NLP Odyssey Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapitools
+
+import "net/http"
+
+// Authenticator applies credentials to an HTTP request built for a generated tool,
+// before it's sent. Implementations must not assume they're called on any particular
+// goroutine, since multiple generated tools may be invoked concurrently.
+type Authenticator interface {
+	Authenticate(req *http.Request) error
+}
+
+// AuthenticatorFunc adapts a plain function to the Authenticator interface.
+type AuthenticatorFunc func(req *http.Request) error
+
+func (f AuthenticatorFunc) Authenticate(req *http.Request) error { return f(req) }
+
+// BearerAuth authenticates requests with an `Authorization: Bearer <token>` header.
+// Token is called on every request, so it can refresh an expiring token.
+type BearerAuth struct {
+	Token func() (string, error)
+}
+
+func (a BearerAuth) Authenticate(req *http.Request) error {
+	token, err := a.Token()
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+// APIKeyLocation is where APIKeyAuth places the key on the request.
+type APIKeyLocation string
+
+const (
+	APIKeyInHeader APIKeyLocation = "header"
+	APIKeyInQuery  APIKeyLocation = "query"
+)
+
+// APIKeyAuth authenticates requests by setting a named header or query parameter.
+type APIKeyAuth struct {
+	Name     string
+	Location APIKeyLocation
+	Key      func() (string, error)
+}
+
+func (a APIKeyAuth) Authenticate(req *http.Request) error {
+	key, err := a.Key()
+	if err != nil {
+		return err
+	}
+	switch a.Location {
+	case APIKeyInQuery:
+		q := req.URL.Query()
+		q.Set(a.Name, key)
+		req.URL.RawQuery = q.Encode()
+	default:
+		req.Header.Set(a.Name, key)
+	}
+	return nil
+}
+
+// BasicAuth authenticates requests with HTTP basic auth.
+type BasicAuth struct {
+	Username string
+	Password func() (string, error)
+}
+
+func (a BasicAuth) Authenticate(req *http.Request) error {
+	password, err := a.Password()
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(a.Username, password)
+	return nil
+}
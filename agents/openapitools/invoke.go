@@ -0,0 +1,180 @@
+// Copyright 2025 The NLP Odyssey Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapitools
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// invocationResult is what a generated tool's OnInvokeTool returns: enough of the HTTP
+// response for the model to reason about the outcome, not just its body.
+type invocationResult struct {
+	Status  int         `json:"status"`
+	Headers http.Header `json:"headers"`
+	Body    any         `json:"body"`
+}
+
+// buildInvoker returns the OnInvokeTool implementation for the FunctionTool generated
+// for op: it substitutes path parameters, encodes query/header parameters, marshals
+// the "body" argument as the JSON request body, applies auth, and performs the call.
+func buildInvoker(client *http.Client, baseURL string, auth Authenticator, op operation) func(ctx context.Context, arguments string) (any, error) {
+	return func(ctx context.Context, arguments string) (any, error) {
+		var args map[string]any
+		if len(arguments) > 0 {
+			if err := json.Unmarshal([]byte(arguments), &args); err != nil {
+				return nil, fmt.Errorf("failed to parse arguments: %w", err)
+			}
+		}
+
+		path, err := substitutePathParams(op.Path, args)
+		if err != nil {
+			return nil, err
+		}
+
+		reqURL, err := url.Parse(baseURL + path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build request URL: %w", err)
+		}
+
+		query := reqURL.Query()
+		var bodyReader io.Reader
+		for _, param := range append(append([]map[string]any{}, op.PathItemParameters...), op.Parameters...) {
+			name, _ := param["name"].(string)
+			in, _ := param["in"].(string)
+			value, ok := args[name]
+			if name == "" || !ok {
+				continue
+			}
+			switch in {
+			case "query":
+				query.Set(name, formatParamValue(value))
+			}
+		}
+		reqURL.RawQuery = query.Encode()
+
+		if op.RequestBody != nil {
+			if body, ok := args["body"]; ok {
+				encoded, err := json.Marshal(body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to encode request body: %w", err)
+				}
+				bodyReader = bytes.NewReader(encoded)
+			}
+		}
+
+		req, err := http.NewRequestWithContext(ctx, op.Method, reqURL.String(), bodyReader)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build request: %w", err)
+		}
+		if bodyReader != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+
+		for _, param := range append(append([]map[string]any{}, op.PathItemParameters...), op.Parameters...) {
+			name, _ := param["name"].(string)
+			in, _ := param["in"].(string)
+			value, ok := args[name]
+			if name == "" || !ok || in != "header" {
+				continue
+			}
+			req.Header.Set(name, formatParamValue(value))
+		}
+
+		if auth != nil {
+			if err := auth.Authenticate(req); err != nil {
+				return nil, fmt.Errorf("failed to authenticate request: %w", err)
+			}
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("request to %s %s failed: %w", op.Method, op.Path, err)
+		}
+		defer resp.Body.Close()
+
+		respBody, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+
+		return invocationResult{
+			Status:  resp.StatusCode,
+			Headers: resp.Header,
+			Body:    decodeResponseBody(respBody, resp.Header.Get("Content-Type")),
+		}, nil
+	}
+}
+
+// decodeResponseBody returns a JSON-decoded value when the response looks like JSON,
+// falling back to the raw string otherwise so non-JSON APIs remain usable.
+func decodeResponseBody(body []byte, contentType string) any {
+	if strings.Contains(contentType, "json") {
+		var v any
+		if err := json.Unmarshal(body, &v); err == nil {
+			return v
+		}
+	}
+	return string(body)
+}
+
+// formatParamValue stringifies an argument value for substitution into a path, query,
+// or header parameter. LLM arguments are unmarshaled into map[string]any, so every JSON
+// number surfaces as a float64; fmt.Sprintf("%v", ...) renders large or whole ones in
+// scientific notation (e.g. 2500000000 becomes "2.5e+09"), which breaks the request
+// URL. Format those without an exponent instead.
+func formatParamValue(value any) string {
+	if f, ok := value.(float64); ok {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+// substitutePathParams replaces every "{name}" placeholder in path with the matching
+// argument, URL-escaped.
+func substitutePathParams(path string, args map[string]any) (string, error) {
+	var b strings.Builder
+	for len(path) > 0 {
+		start := strings.IndexByte(path, '{')
+		if start < 0 {
+			b.WriteString(path)
+			break
+		}
+		end := strings.IndexByte(path[start:], '}')
+		if end < 0 {
+			b.WriteString(path)
+			break
+		}
+		end += start
+
+		b.WriteString(path[:start])
+		name := path[start+1 : end]
+		value, ok := args[name]
+		if !ok {
+			return "", fmt.Errorf("missing required path parameter %q", name)
+		}
+		b.WriteString(url.PathEscape(formatParamValue(value)))
+
+		path = path[end+1:]
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,133 @@
+// Copyright 2025 The NLP Odyssey Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapitools
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubstitutePathParams(t *testing.T) {
+	t.Run("substitutes and escapes path params", func(t *testing.T) {
+		got, err := substitutePathParams("/pets/{petId}/photos/{photoId}", map[string]any{
+			"petId":   "a b",
+			"photoId": 42,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "/pets/a%20b/photos/42", got)
+	})
+
+	t.Run("errors on a missing required param", func(t *testing.T) {
+		_, err := substitutePathParams("/pets/{petId}", map[string]any{})
+		assert.Error(t, err)
+	})
+
+	t.Run("formats a large integral float64 without scientific notation", func(t *testing.T) {
+		// LLM arguments are unmarshaled into map[string]any, so a JSON integer like
+		// 2500000000 arrives as a float64, not an int.
+		got, err := substitutePathParams("/pets/{petId}", map[string]any{"petId": float64(2500000000)})
+		require.NoError(t, err)
+		assert.Equal(t, "/pets/2500000000", got)
+	})
+}
+
+func TestBuildInvoker(t *testing.T) {
+	var gotMethod, gotPath, gotQuery, gotHeader, gotBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		gotPath = r.URL.Path
+		gotQuery = r.URL.Query().Get("limit")
+		gotHeader = r.Header.Get("X-Trace-Id")
+		body, _ := io.ReadAll(r.Body)
+		gotBody = string(body)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	defer server.Close()
+
+	op := operation{
+		Method: http.MethodPost,
+		Path:   "/pets/{petId}",
+		Parameters: []map[string]any{
+			{"name": "petId", "in": "path"},
+			{"name": "limit", "in": "query"},
+			{"name": "X-Trace-Id", "in": "header"},
+		},
+		RequestBody: map[string]any{"required": true},
+	}
+
+	invoke := buildInvoker(server.Client(), server.URL, nil, op)
+
+	arguments := `{"petId":"123","limit":"5","X-Trace-Id":"abc","body":{"name":"Rex"}}`
+	result, err := invoke(t.Context(), arguments)
+	require.NoError(t, err)
+
+	assert.Equal(t, http.MethodPost, gotMethod)
+	assert.Equal(t, "/pets/123", gotPath)
+	assert.Equal(t, "5", gotQuery)
+	assert.Equal(t, "abc", gotHeader)
+	assert.JSONEq(t, `{"name":"Rex"}`, gotBody)
+
+	invocationResult, ok := result.(invocationResult)
+	require.True(t, ok)
+	assert.Equal(t, http.StatusCreated, invocationResult.Status)
+	assert.Equal(t, map[string]any{"ok": true}, invocationResult.Body)
+}
+
+func TestBuildInvoker_LargeIntegerQueryParam(t *testing.T) {
+	var gotQuery string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.Query().Get("accountId")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	defer server.Close()
+
+	op := operation{
+		Method: http.MethodGet,
+		Path:   "/accounts",
+		Parameters: []map[string]any{
+			{"name": "accountId", "in": "query"},
+		},
+	}
+
+	invoke := buildInvoker(server.Client(), server.URL, nil, op)
+
+	// accountId is a JSON number, not a string, so it reaches buildInvoker as a
+	// float64 after json.Unmarshal - the case fmt.Sprintf("%v", ...) used to render
+	// in scientific notation.
+	_, err := invoke(t.Context(), `{"accountId":2500000000}`)
+	require.NoError(t, err)
+	assert.Equal(t, "2500000000", gotQuery)
+}
+
+func TestDecodeResponseBody(t *testing.T) {
+	t.Run("decodes JSON bodies", func(t *testing.T) {
+		got := decodeResponseBody([]byte(`{"a":1}`), "application/json")
+		assert.Equal(t, map[string]any{"a": float64(1)}, got)
+	})
+
+	t.Run("falls back to the raw string for non-JSON bodies", func(t *testing.T) {
+		got := decodeResponseBody([]byte("plain text"), "text/plain")
+		assert.Equal(t, "plain text", got)
+	})
+}
@@ -0,0 +1,301 @@
+// Copyright 2025 The NLP Odyssey Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapitools
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/openai/openai-go/v2/packages/param"
+	"github.com/wuhei/openai-agents-go/agents"
+	"gopkg.in/yaml.v3"
+)
+
+// Options configures how LoadOpenAPISpec turns an OpenAPI document into FunctionTools.
+type Options struct {
+	// BaseURL overrides the server URL the generated tools call. If empty, the first
+	// entry of the document's top-level `servers` is used.
+	BaseURL string
+
+	// Auth, if set, is applied to every outgoing request made by a generated tool.
+	Auth Authenticator
+
+	// HTTPClient is used to make requests. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+
+	// IncludeOperationIDs, if non-empty, restricts generated tools to these
+	// operationIds. If ExcludeOperationIDs is also set, exclusion is applied after
+	// inclusion.
+	IncludeOperationIDs []string
+
+	// ExcludeOperationIDs skips these operationIds, even if IncludeOperationIDs would
+	// otherwise have matched them.
+	ExcludeOperationIDs []string
+}
+
+// LoadOpenAPISpec parses the OpenAPI 3.0/3.1 document at specURLOrPath (a local file
+// path, or an http(s) URL) and returns one agents.FunctionTool per operation. Each
+// tool's OnInvokeTool performs the corresponding HTTP call: path/query/header
+// parameters and the JSON request body are taken from the LLM-supplied arguments, as
+// described by the tool's ParamsJSONSchema.
+func LoadOpenAPISpec(ctx context.Context, specURLOrPath string, opts Options) ([]agents.FunctionTool, error) {
+	raw, err := readSpec(ctx, specURLOrPath, opts.HTTPClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read OpenAPI spec %q: %w", specURLOrPath, err)
+	}
+
+	root, err := decodeSpec(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse OpenAPI spec %q: %w", specURLOrPath, err)
+	}
+
+	baseURL := strings.TrimSuffix(opts.BaseURL, "/")
+	if baseURL == "" {
+		baseURL = strings.TrimSuffix(firstServerURL(root), "/")
+	}
+
+	ops, err := collectOperations(root)
+	if err != nil {
+		return nil, fmt.Errorf("failed to walk OpenAPI paths in %q: %w", specURLOrPath, err)
+	}
+
+	include := toSet(opts.IncludeOperationIDs)
+	exclude := toSet(opts.ExcludeOperationIDs)
+
+	client := opts.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	tools := make([]agents.FunctionTool, 0, len(ops))
+	for _, op := range ops {
+		if len(include) > 0 && !include[op.OperationID] {
+			continue
+		}
+		if exclude[op.OperationID] {
+			continue
+		}
+
+		tool, err := buildTool(root, baseURL, client, opts.Auth, op)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build tool for operation %q: %w", op.OperationID, err)
+		}
+		tools = append(tools, tool)
+	}
+
+	return tools, nil
+}
+
+func readSpec(ctx context.Context, specURLOrPath string, client *http.Client) ([]byte, error) {
+	if strings.HasPrefix(specURLOrPath, "http://") || strings.HasPrefix(specURLOrPath, "https://") {
+		if client == nil {
+			client = http.DefaultClient
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, specURLOrPath, nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode >= 300 {
+			return nil, fmt.Errorf("unexpected status %d fetching spec", resp.StatusCode)
+		}
+		return io.ReadAll(resp.Body)
+	}
+	return os.ReadFile(specURLOrPath)
+}
+
+// decodeSpec decodes a JSON or YAML OpenAPI document into a plain map[string]any, so
+// the rest of the package can navigate it (and resolve $refs against it) uniformly.
+func decodeSpec(raw []byte) (map[string]any, error) {
+	trimmed := bytes.TrimSpace(raw)
+	if len(trimmed) > 0 && trimmed[0] == '{' {
+		var root map[string]any
+		if err := json.Unmarshal(trimmed, &root); err != nil {
+			return nil, err
+		}
+		return root, nil
+	}
+
+	var root map[string]any
+	if err := yaml.Unmarshal(raw, &root); err != nil {
+		return nil, err
+	}
+	return root, nil
+}
+
+func firstServerURL(root map[string]any) string {
+	servers, _ := root["servers"].([]any)
+	for _, s := range servers {
+		if m, ok := s.(map[string]any); ok {
+			if url, ok := m["url"].(string); ok && url != "" {
+				return url
+			}
+		}
+	}
+	return ""
+}
+
+// collectOperations walks every path item in root["paths"] and returns one operation
+// per HTTP method defined there, in a stable (path, then method) order.
+func collectOperations(root map[string]any) ([]operation, error) {
+	pathsAny, _ := root["paths"].(map[string]any)
+
+	paths := make([]string, 0, len(pathsAny))
+	for p := range pathsAny {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	var ops []operation
+	for _, path := range paths {
+		pathItem, ok := pathsAny[path].(map[string]any)
+		if !ok {
+			continue
+		}
+		pathItem, err := deref(root, pathItem)
+		if err != nil {
+			return nil, fmt.Errorf("path %q: %w", path, err)
+		}
+
+		sharedParams, err := extractParameters(root, pathItem["parameters"])
+		if err != nil {
+			return nil, fmt.Errorf("path %q: %w", path, err)
+		}
+
+		for _, method := range httpMethods {
+			opAny, ok := pathItem[method]
+			if !ok {
+				continue
+			}
+			opMap, ok := opAny.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			ownParams, err := extractParameters(root, opMap["parameters"])
+			if err != nil {
+				return nil, fmt.Errorf("%s %q: %w", strings.ToUpper(method), path, err)
+			}
+
+			requestBody, err := extractRequestBody(root, opMap["requestBody"])
+			if err != nil {
+				return nil, fmt.Errorf("%s %q: %w", strings.ToUpper(method), path, err)
+			}
+
+			operationID, _ := opMap["operationId"].(string)
+			if operationID == "" {
+				operationID = fallbackOperationID(method, path)
+			}
+
+			ops = append(ops, operation{
+				Method:             strings.ToUpper(method),
+				Path:               path,
+				OperationID:        operationID,
+				Summary:            stringField(opMap, "summary"),
+				Description:        stringField(opMap, "description"),
+				PathItemParameters: sharedParams,
+				Parameters:         ownParams,
+				RequestBody:        requestBody,
+			})
+		}
+	}
+	return ops, nil
+}
+
+func extractParameters(root map[string]any, v any) ([]map[string]any, error) {
+	items, _ := v.([]any)
+	params := make([]map[string]any, 0, len(items))
+	for _, item := range items {
+		m, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		resolved, err := deref(root, m)
+		if err != nil {
+			return nil, err
+		}
+		params = append(params, resolved)
+	}
+	return params, nil
+}
+
+func extractRequestBody(root map[string]any, v any) (map[string]any, error) {
+	m, ok := v.(map[string]any)
+	if !ok {
+		return nil, nil
+	}
+	return deref(root, m)
+}
+
+func stringField(m map[string]any, key string) string {
+	s, _ := m[key].(string)
+	return s
+}
+
+func toSet(items []string) map[string]bool {
+	if len(items) == 0 {
+		return nil
+	}
+	set := make(map[string]bool, len(items))
+	for _, item := range items {
+		set[item] = true
+	}
+	return set
+}
+
+func fallbackOperationID(method, path string) string {
+	slug := strings.NewReplacer("/", "_", "{", "", "}", "").Replace(path)
+	return strings.Trim(method+slug, "_")
+}
+
+func buildTool(root map[string]any, baseURL string, client *http.Client, auth Authenticator, op operation) (agents.FunctionTool, error) {
+	name := sanitizeToolName(op.OperationID)
+
+	schema, err := buildOperationSchema(root, op)
+	if err != nil {
+		return agents.FunctionTool{}, err
+	}
+	schema, err = agents.EnsureStrictJSONSchema(schema)
+	if err != nil {
+		return agents.FunctionTool{}, fmt.Errorf("failed to ensure strictness of generated schema: %w", err)
+	}
+
+	description := op.Summary
+	if op.Description != "" {
+		if description != "" {
+			description += "\n\n"
+		}
+		description += op.Description
+	}
+
+	return agents.FunctionTool{
+		Name:             name,
+		Description:      description,
+		ParamsJSONSchema: schema,
+		StrictJSONSchema: param.NewOpt(true),
+		OnInvokeTool:     buildInvoker(client, baseURL, auth, op),
+	}, nil
+}
@@ -0,0 +1,39 @@
+// Copyright 2025 The NLP Odyssey Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapitools
+
+import "strings"
+
+// maxToolNameLength mirrors OpenAI's limit on function tool names.
+const maxToolNameLength = 64
+
+// sanitizeToolName turns an operationId into a name that satisfies OpenAI's function
+// name constraints: only letters, digits, underscores and dashes, at most 64 chars.
+func sanitizeToolName(operationID string) string {
+	var b strings.Builder
+	for _, r := range operationID {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_', r == '-':
+			b.WriteRune(r)
+		default:
+			b.WriteRune('_')
+		}
+	}
+	name := b.String()
+	if len(name) > maxToolNameLength {
+		name = name[:maxToolNameLength]
+	}
+	return name
+}
@@ -0,0 +1,175 @@
+// Copyright 2025 The NLP Odyssey Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapitools
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// resolveRef follows a local "#/a/b/c" JSON Pointer against root. External refs
+// (pointing at another file or URL) aren't supported and return an error: the spec
+// should be fully self-contained, or pre-flattened before being passed in.
+func resolveRef(root map[string]any, ref string) (map[string]any, error) {
+	if !strings.HasPrefix(ref, "#/") {
+		return nil, fmt.Errorf("unsupported non-local $ref %q", ref)
+	}
+
+	var cur any = root
+	for _, rawSegment := range strings.Split(ref[2:], "/") {
+		segment := unescapeJSONPointerSegment(rawSegment)
+		switch node := cur.(type) {
+		case map[string]any:
+			v, ok := node[segment]
+			if !ok {
+				return nil, fmt.Errorf("$ref %q: no such key %q", ref, segment)
+			}
+			cur = v
+		case []any:
+			idx, err := strconv.Atoi(segment)
+			if err != nil || idx < 0 || idx >= len(node) {
+				return nil, fmt.Errorf("$ref %q: invalid array index %q", ref, segment)
+			}
+			cur = node[idx]
+		default:
+			return nil, fmt.Errorf("$ref %q: cannot descend into %T at %q", ref, cur, segment)
+		}
+	}
+
+	resolved, ok := cur.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("$ref %q does not resolve to an object", ref)
+	}
+	return resolved, nil
+}
+
+func unescapeJSONPointerSegment(s string) string {
+	s = strings.ReplaceAll(s, "~1", "/")
+	s = strings.ReplaceAll(s, "~0", "~")
+	return s
+}
+
+// deref resolves node if it's a Reference Object ({"$ref": "..."}), following chains
+// of refs until it reaches a concrete object. It returns node unchanged otherwise.
+func deref(root map[string]any, node map[string]any) (map[string]any, error) {
+	seen := map[string]bool{}
+	for {
+		ref, ok := node["$ref"].(string)
+		if !ok {
+			return node, nil
+		}
+		if seen[ref] {
+			return nil, fmt.Errorf("$ref cycle detected at %q", ref)
+		}
+		seen[ref] = true
+
+		resolved, err := resolveRef(root, ref)
+		if err != nil {
+			return nil, err
+		}
+		node = resolved
+	}
+}
+
+// flattenSchema resolves $refs and merges allOf/oneOf branches into a single object
+// schema, so the result tolerates OpenAI's strict mode (which doesn't support these
+// composition keywords). oneOf branches are merged permissively: every property across
+// every branch is made optional, since strict mode has no way to express "exactly one
+// of these shapes".
+func flattenSchema(root map[string]any, schema map[string]any) (map[string]any, error) {
+	if schema == nil {
+		return nil, nil
+	}
+	schema, err := deref(root, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := map[string]any{}
+	for k, v := range schema {
+		if k == "allOf" || k == "oneOf" {
+			continue
+		}
+		merged[k] = v
+	}
+
+	for _, key := range []string{"allOf", "oneOf"} {
+		branches, ok := schema[key].([]any)
+		if !ok {
+			continue
+		}
+		for _, b := range branches {
+			branchSchema, ok := b.(map[string]any)
+			if !ok {
+				continue
+			}
+			flattenedBranch, err := flattenSchema(root, branchSchema)
+			if err != nil {
+				return nil, err
+			}
+			// allOf branches are all required to hold at once, so their "required"
+			// lists union. oneOf branches are mutually exclusive: a property required
+			// by one branch isn't required by the synthesized schema, since another
+			// branch may not have it at all. Drop "required" for oneOf entirely,
+			// matching the "every property made optional" behavior described above.
+			mergeObjectSchemas(merged, flattenedBranch, key == "allOf")
+		}
+	}
+
+	if _, ok := merged["type"]; !ok {
+		if _, hasProps := merged["properties"]; hasProps {
+			merged["type"] = "object"
+		}
+	}
+
+	return merged, nil
+}
+
+// mergeObjectSchemas merges src's properties, type, and - if mergeRequired is true -
+// required fields into dst, in place. It's used to flatten allOf/oneOf branches
+// (mergeRequired only for allOf, since oneOf branches are mutually exclusive).
+func mergeObjectSchemas(dst, src map[string]any, mergeRequired bool) {
+	if len(src) == 0 {
+		return
+	}
+
+	if dstProps, ok := dst["properties"].(map[string]any); ok {
+		if srcProps, ok := src["properties"].(map[string]any); ok {
+			for k, v := range srcProps {
+				dstProps[k] = v
+			}
+		}
+	} else if srcProps, ok := src["properties"].(map[string]any); ok {
+		propsCopy := make(map[string]any, len(srcProps))
+		for k, v := range srcProps {
+			propsCopy[k] = v
+		}
+		dst["properties"] = propsCopy
+	}
+
+	if mergeRequired {
+		if srcRequired, ok := src["required"].([]any); ok {
+			dstRequired, _ := dst["required"].([]any)
+			dst["required"] = append(dstRequired, srcRequired...)
+		}
+	}
+
+	if _, ok := dst["type"]; !ok {
+		if t, ok := src["type"]; ok {
+			dst["type"] = t
+		}
+	}
+}
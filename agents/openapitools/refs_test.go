@@ -0,0 +1,134 @@
+// Copyright 2025 The NLP Odyssey Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapitools
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveRef(t *testing.T) {
+	root := map[string]any{
+		"components": map[string]any{
+			"schemas": map[string]any{
+				"Pet": map[string]any{"type": "object"},
+			},
+		},
+	}
+
+	t.Run("resolves a local pointer", func(t *testing.T) {
+		got, err := resolveRef(root, "#/components/schemas/Pet")
+		require.NoError(t, err)
+		assert.Equal(t, "object", got["type"])
+	})
+
+	t.Run("rejects non-local refs", func(t *testing.T) {
+		_, err := resolveRef(root, "other.yaml#/Pet")
+		assert.Error(t, err)
+	})
+
+	t.Run("errors on a missing key", func(t *testing.T) {
+		_, err := resolveRef(root, "#/components/schemas/Missing")
+		assert.Error(t, err)
+	})
+}
+
+func TestFlattenSchema_AllOf_UnionsRequired(t *testing.T) {
+	schema := map[string]any{
+		"allOf": []any{
+			map[string]any{
+				"type":       "object",
+				"properties": map[string]any{"id": map[string]any{"type": "string"}},
+				"required":   []any{"id"},
+			},
+			map[string]any{
+				"type":       "object",
+				"properties": map[string]any{"name": map[string]any{"type": "string"}},
+				"required":   []any{"name"},
+			},
+		},
+	}
+
+	got, err := flattenSchema(nil, schema)
+	require.NoError(t, err)
+
+	required, _ := got["required"].([]any)
+	assert.ElementsMatch(t, []any{"id", "name"}, required)
+	properties, _ := got["properties"].(map[string]any)
+	assert.Contains(t, properties, "id")
+	assert.Contains(t, properties, "name")
+}
+
+func TestFlattenSchema_OneOf_DropsRequired(t *testing.T) {
+	schema := map[string]any{
+		"oneOf": []any{
+			map[string]any{
+				"type":       "object",
+				"properties": map[string]any{"cat_name": map[string]any{"type": "string"}},
+				"required":   []any{"cat_name"},
+			},
+			map[string]any{
+				"type":       "object",
+				"properties": map[string]any{"dog_name": map[string]any{"type": "string"}},
+				"required":   []any{"dog_name"},
+			},
+		},
+	}
+
+	got, err := flattenSchema(nil, schema)
+	require.NoError(t, err)
+
+	// Neither branch's required fields survive: requiring both would make the
+	// synthesized schema unsatisfiable, since the branches are mutually exclusive.
+	assert.Empty(t, got["required"])
+	properties, _ := got["properties"].(map[string]any)
+	assert.Contains(t, properties, "cat_name")
+	assert.Contains(t, properties, "dog_name")
+}
+
+func TestFlattenSchema_ResolvesRefs(t *testing.T) {
+	root := map[string]any{
+		"components": map[string]any{
+			"schemas": map[string]any{
+				"Pet": map[string]any{
+					"type":       "object",
+					"properties": map[string]any{"name": map[string]any{"type": "string"}},
+					"required":   []any{"name"},
+				},
+			},
+		},
+	}
+
+	got, err := flattenSchema(root, map[string]any{"$ref": "#/components/schemas/Pet"})
+	require.NoError(t, err)
+	assert.Equal(t, "object", got["type"])
+	assert.Equal(t, []any{"name"}, got["required"])
+}
+
+func TestMergeObjectSchemas(t *testing.T) {
+	t.Run("mergeRequired true unions required", func(t *testing.T) {
+		dst := map[string]any{"required": []any{"a"}}
+		mergeObjectSchemas(dst, map[string]any{"required": []any{"b"}}, true)
+		assert.ElementsMatch(t, []any{"a", "b"}, dst["required"])
+	})
+
+	t.Run("mergeRequired false leaves dst's required alone", func(t *testing.T) {
+		dst := map[string]any{"required": []any{"a"}}
+		mergeObjectSchemas(dst, map[string]any{"required": []any{"b"}}, false)
+		assert.Equal(t, []any{"a"}, dst["required"])
+	})
+}
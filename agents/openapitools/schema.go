@@ -0,0 +1,109 @@
+// Copyright 2025 The NLP Odyssey Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapitools
+
+import "fmt"
+
+// buildOperationSchema synthesizes a single object schema for an operation's
+// arguments, merging its path/query/header parameters as top-level properties and its
+// JSON request body, if any, under a "body" property.
+func buildOperationSchema(root map[string]any, op operation) (map[string]any, error) {
+	properties := map[string]any{}
+	var required []any
+
+	seen := map[string]bool{}
+	for _, param := range append(append([]map[string]any{}, op.PathItemParameters...), op.Parameters...) {
+		name, _ := param["name"].(string)
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		paramSchema, _ := param["schema"].(map[string]any)
+		if paramSchema == nil {
+			paramSchema = map[string]any{"type": "string"}
+		}
+		flattened, err := flattenSchema(root, paramSchema)
+		if err != nil {
+			return nil, fmt.Errorf("parameter %q: %w", name, err)
+		}
+		if _, hasDescription := flattened["description"]; !hasDescription {
+			if description, _ := param["description"].(string); description != "" {
+				flattened["description"] = description
+			}
+		}
+		properties[name] = flattened
+
+		if isRequired, _ := param["required"].(bool); isRequired {
+			required = append(required, name)
+		}
+	}
+
+	if op.RequestBody != nil {
+		bodySchema, bodyRequired, err := bodySchemaFor(root, op.RequestBody)
+		if err != nil {
+			return nil, fmt.Errorf("requestBody: %w", err)
+		}
+		if bodySchema != nil {
+			properties["body"] = bodySchema
+			if bodyRequired {
+				required = append(required, "body")
+			}
+		}
+	}
+
+	return map[string]any{
+		"type":                 "object",
+		"properties":           properties,
+		"required":             required,
+		"additionalProperties": false,
+	}, nil
+}
+
+// bodySchemaFor extracts the JSON schema of a Request Body Object's
+// `application/json` media type (or, failing that, one of its other media types, in
+// no defined order), flattened for strict-mode compatibility.
+func bodySchemaFor(root map[string]any, requestBody map[string]any) (map[string]any, bool, error) {
+	content, _ := requestBody["content"].(map[string]any)
+	if len(content) == 0 {
+		return nil, false, nil
+	}
+
+	mediaType, ok := content["application/json"].(map[string]any)
+	if !ok {
+		for _, v := range content {
+			if m, ok := v.(map[string]any); ok {
+				mediaType = m
+				break
+			}
+		}
+	}
+	if mediaType == nil {
+		return nil, false, nil
+	}
+
+	rawSchema, _ := mediaType["schema"].(map[string]any)
+	if rawSchema == nil {
+		return nil, false, nil
+	}
+
+	flattened, err := flattenSchema(root, rawSchema)
+	if err != nil {
+		return nil, false, err
+	}
+
+	required, _ := requestBody["required"].(bool)
+	return flattened, required, nil
+}
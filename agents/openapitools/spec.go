@@ -0,0 +1,41 @@
+// Copyright 2025 The NLP Odyssey Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package openapitools materializes FunctionTools from an OpenAPI 3.0/3.1 document,
+// so an entire REST API can be exposed to an Agent without hand-writing a tool per
+// endpoint.
+package openapitools
+
+// httpMethods lists the OpenAPI path item fields that describe an operation, in the
+// order we want them considered when generating tools.
+var httpMethods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+// operation is the subset of an OpenAPI Operation Object this package acts on. The
+// rest of the document is kept as raw map[string]any and navigated on demand, since
+// $ref indirection makes a fully typed model more trouble than it's worth.
+type operation struct {
+	Method      string
+	Path        string
+	OperationID string
+	Summary     string
+	Description string
+
+	// PathItemParameters and Parameters are Parameter Objects (already $ref-resolved),
+	// in OpenAPI's own format: {"name":..., "in":..., "required":..., "schema":{...}}.
+	PathItemParameters []map[string]any
+	Parameters         []map[string]any
+
+	// RequestBody is a (possibly nil) $ref-resolved Request Body Object.
+	RequestBody map[string]any
+}
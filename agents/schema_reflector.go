@@ -0,0 +1,86 @@
+// Copyright 2025 The NLP Odyssey Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agents
+
+import (
+	"fmt"
+	"reflect"
+
+	googlejsonschema "github.com/google/jsonschema-go/jsonschema"
+	invopopjsonschema "github.com/invopop/jsonschema"
+	"github.com/nlpodyssey/openai-agents-go/util"
+)
+
+// SchemaReflector generates a JSON schema, as a map[string]any, from a Go type. It's
+// the pluggable backend behind NewFunctionToolWithOptions, so callers aren't stuck
+// with whichever reflection library a given FunctionTool happened to be built with.
+type SchemaReflector interface {
+	Reflect(t reflect.Type) (map[string]any, error)
+}
+
+// InvopopReflector generates schemas with github.com/invopop/jsonschema. It's the
+// reflector SafeNewFunctionTool and NewFunctionTool have always used, and remains the
+// package default.
+type InvopopReflector struct{}
+
+func (InvopopReflector) Reflect(t reflect.Type) (map[string]any, error) {
+	reflector := &invopopjsonschema.Reflector{
+		ExpandedStruct:             true,
+		RequiredFromJSONSchemaTags: false,
+		AllowAdditionalProperties:  false,
+	}
+
+	var schema *invopopjsonschema.Schema
+	if t.Kind() == reflect.Struct && t.Name() == "" && t.NumField() == 0 {
+		// Avoid panic in jsonschema when reflecting an anonymous empty struct.
+		schema = &invopopjsonschema.Schema{
+			Version:    invopopjsonschema.Version,
+			Type:       "object",
+			Properties: invopopjsonschema.NewProperties(),
+		}
+		if !reflector.AllowAdditionalProperties {
+			schema.AdditionalProperties = invopopjsonschema.FalseSchema
+		}
+	} else {
+		schema = reflector.ReflectFromType(t)
+	}
+
+	return util.JSONMap(schema)
+}
+
+// GoogleReflector generates schemas with github.com/google/jsonschema-go, which
+// targets JSON Schema draft 2020-12 and supports features invopop doesn't handle the
+// same way, such as proper $defs and prefixItems.
+type GoogleReflector struct{}
+
+func (GoogleReflector) Reflect(t reflect.Type) (map[string]any, error) {
+	// Unlike For, ForType doesn't nil-check opts itself before dereferencing it.
+	schema, err := googlejsonschema.ForType(t, &googlejsonschema.ForOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("google/jsonschema-go: failed to reflect type %s: %w", t, err)
+	}
+	return util.JSONMap(schema)
+}
+
+var defaultSchemaReflector SchemaReflector = InvopopReflector{}
+
+// SetDefaultSchemaReflector changes the SchemaReflector used by NewFunctionTool,
+// SafeNewFunctionTool and NewFunctionToolWithOptions calls that don't pass
+// WithSchemaReflector explicitly. It's meant to be called once, e.g. from an init
+// function, for applications that want every tool in the process to use the same
+// non-default reflector.
+func SetDefaultSchemaReflector(reflector SchemaReflector) {
+	defaultSchemaReflector = reflector
+}
@@ -0,0 +1,106 @@
+// Copyright 2025 The NLP Odyssey Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agents_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wuhei/openai-agents-go/agents"
+)
+
+type reflectorArgs struct {
+	City string `json:"city"`
+}
+
+func TestInvopopReflector(t *testing.T) {
+	schema, err := agents.InvopopReflector{}.Reflect(reflect.TypeOf(reflectorArgs{}))
+	require.NoError(t, err)
+	assert.Equal(t, "object", schema["type"])
+	properties, ok := schema["properties"].(map[string]any)
+	require.True(t, ok)
+	assert.Contains(t, properties, "city")
+}
+
+func TestGoogleReflector(t *testing.T) {
+	schema, err := agents.GoogleReflector{}.Reflect(reflect.TypeOf(reflectorArgs{}))
+	require.NoError(t, err)
+	assert.Equal(t, "object", schema["type"])
+	properties, ok := schema["properties"].(map[string]any)
+	require.True(t, ok)
+	assert.Contains(t, properties, "city")
+}
+
+func TestNewFunctionToolWithOptions_WithSchemaReflector(t *testing.T) {
+	handler := func(_ context.Context, args reflectorArgs) (string, error) { return args.City, nil }
+
+	invopopTool, err := agents.NewFunctionToolWithOptions("echo_city", "", handler,
+		agents.WithSchemaReflector(agents.InvopopReflector{}))
+	require.NoError(t, err)
+
+	googleTool, err := agents.NewFunctionToolWithOptions("echo_city", "", handler,
+		agents.WithSchemaReflector(agents.GoogleReflector{}))
+	require.NoError(t, err)
+
+	// Both reflectors describe the same Go type, so the resulting tools should agree
+	// on the basics even if their $defs/strict-mode plumbing differs.
+	assert.Equal(t, invopopTool.ParamsJSONSchema["type"], googleTool.ParamsJSONSchema["type"])
+}
+
+func TestNewFunctionToolWithOptions_WithSchemaTransform(t *testing.T) {
+	handler := func(_ context.Context, args reflectorArgs) (string, error) { return args.City, nil }
+
+	tool, err := agents.NewFunctionToolWithOptions("echo_city", "", handler,
+		agents.WithSchemaTransform(func(schema map[string]any) map[string]any {
+			schema["x-vendor"] = "acme"
+			return schema
+		}),
+		agents.WithSchemaTransform(func(schema map[string]any) map[string]any {
+			schema["x-vendor"] = schema["x-vendor"].(string) + "-v2"
+			return schema
+		}),
+	)
+	require.NoError(t, err)
+
+	// Transforms run in the order they were passed.
+	assert.Equal(t, "acme-v2", tool.ParamsJSONSchema["x-vendor"])
+}
+
+func TestSetDefaultSchemaReflector(t *testing.T) {
+	t.Cleanup(func() { agents.SetDefaultSchemaReflector(agents.InvopopReflector{}) })
+
+	handler := func(_ context.Context, args reflectorArgs) (string, error) { return args.City, nil }
+
+	// InvopopReflector is the package default: SafeNewFunctionTool (and NewFunctionTool,
+	// which wraps it) stamp their schema with invopop's "$id"/"$schema" keys, which
+	// GoogleReflector doesn't produce.
+	before, err := agents.SafeNewFunctionTool("echo_city", "", handler)
+	require.NoError(t, err)
+	assert.Contains(t, before.ParamsJSONSchema, "$schema")
+
+	agents.SetDefaultSchemaReflector(agents.GoogleReflector{})
+
+	after, err := agents.SafeNewFunctionTool("echo_city", "", handler)
+	require.NoError(t, err)
+	assert.NotContains(t, after.ParamsJSONSchema, "$schema")
+	assert.Equal(t, "object", after.ParamsJSONSchema["type"])
+
+	builderTool, err := agents.NewFunctionToolWithOptions("echo_city", "", handler)
+	require.NoError(t, err)
+	assert.NotContains(t, builderTool.ParamsJSONSchema, "$schema")
+}
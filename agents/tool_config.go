@@ -0,0 +1,121 @@
+// Copyright 2025 The NLP Odyssey Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agents
+
+import (
+	"github.com/nlpodyssey/openai-agents-go/modelsettings"
+)
+
+// FunctionCallingMode constrains how the model is allowed to pick tools on a turn.
+// It mirrors the tool_choice configuration of the OpenAI Chat Completions and
+// Responses APIs, and the constrained-decoding modes of the Vertex AI SDK.
+type FunctionCallingMode string
+
+const (
+	// ToolChoiceAuto lets the model decide whether to call a tool, and which one.
+	// This is the default.
+	ToolChoiceAuto FunctionCallingMode = "auto"
+
+	// ToolChoiceAny forces the model to call one of the available tools, without
+	// constraining which one.
+	ToolChoiceAny FunctionCallingMode = "any"
+
+	// ToolChoiceNone prevents the model from calling any tool on this turn.
+	ToolChoiceNone FunctionCallingMode = "none"
+
+	// ToolChoiceRequired is an alias for ToolChoiceAny, kept for parity with
+	// providers that name the same behavior "required".
+	ToolChoiceRequired FunctionCallingMode = "required"
+)
+
+// ToolConfig configures how an Agent selects among its available tools on a given
+// turn, on top of the per-tool IsEnabled checks each Tool already supports.
+//
+// Set it as Agent.ToolConfig. The runner consults it on every turn, via
+// ResolveToolChoice and FilterAllowedTools, before calling the model.
+type ToolConfig struct {
+	// Mode controls whether the model must, may, or must not call a tool this turn.
+	// Defaults to ToolChoiceAuto if left empty.
+	Mode FunctionCallingMode
+
+	// AllowedToolNames, if non-empty, restricts the tools exposed to the model to
+	// this subset, independently of each tool's IsEnabled. It doesn't override Mode:
+	// with ToolChoiceAuto, narrowing to one tool still leaves the model free to call
+	// it or not. Only with ToolChoiceAny/ToolChoiceRequired does ResolveToolChoice pin
+	// tool_choice to that one tool by name, since the model must call something anyway.
+	AllowedToolNames []string
+
+	// MaxToolCalls, if greater than zero, caps how many tool calls the agent may make
+	// over the lifetime of a single run (not per turn, despite how that reads -
+	// toolCallsSoFar accumulates across every turn of the run). Once that budget is
+	// reached, ResolveToolChoice forces ToolChoiceNone regardless of Mode, which is a
+	// common way to break an agent out of a tool-call loop.
+	MaxToolCalls int
+}
+
+// ResolveToolChoice translates cfg into the modelsettings.ToolChoice to send to the
+// model for the next turn. toolCallsSoFar is the number of tool calls already made
+// during the run; once it reaches cfg.MaxToolCalls, ToolChoiceNone is forced so the
+// agent can no longer call tools and is pushed toward a final answer.
+//
+// AllowedToolNames only narrows which tools are exposed - see FilterAllowedTools - it
+// never overrides Mode. In particular, ToolChoiceAuto with a single allowed tool still
+// resolves to ToolChoiceAuto: the model is free to call that one tool or not. Only
+// ToolChoiceAny/ToolChoiceRequired pin tool_choice to a single allowed tool's name, as
+// a modelsettings.ToolChoiceString; chatCmplConverter and the Responses API both
+// translate that to the single-tool {"type":"function","function":{"name":...}} form.
+func ResolveToolChoice(cfg ToolConfig, toolCallsSoFar int) modelsettings.ToolChoice {
+	if cfg.MaxToolCalls > 0 && toolCallsSoFar >= cfg.MaxToolCalls {
+		return modelsettings.ToolChoiceNone
+	}
+	switch cfg.Mode {
+	case ToolChoiceNone:
+		return modelsettings.ToolChoiceNone
+	case ToolChoiceAny, ToolChoiceRequired:
+		if len(cfg.AllowedToolNames) == 1 {
+			return modelsettings.ToolChoiceString(cfg.AllowedToolNames[0])
+		}
+		return modelsettings.ToolChoiceRequired
+	default:
+		return modelsettings.ToolChoiceAuto
+	}
+}
+
+// namedTool is satisfied by any Tool implementation. It's declared locally, requiring
+// only the method every Tool already exposes, so FilterAllowedTools doesn't need to
+// depend on the full Tool interface definition.
+type namedTool interface {
+	ToolName() string
+}
+
+// FilterAllowedTools restricts tools to cfg.AllowedToolNames, independently of
+// whatever IsEnabled each tool configures. If cfg.AllowedToolNames is empty, tools is
+// returned unchanged.
+func FilterAllowedTools[T namedTool](cfg ToolConfig, tools []T) []T {
+	if len(cfg.AllowedToolNames) == 0 {
+		return tools
+	}
+	allowed := make(map[string]struct{}, len(cfg.AllowedToolNames))
+	for _, name := range cfg.AllowedToolNames {
+		allowed[name] = struct{}{}
+	}
+	filtered := make([]T, 0, len(tools))
+	for _, tool := range tools {
+		if _, ok := allowed[tool.ToolName()]; ok {
+			filtered = append(filtered, tool)
+		}
+	}
+	return filtered
+}
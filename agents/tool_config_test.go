@@ -0,0 +1,130 @@
+// Copyright 2025 The NLP Odyssey Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agents_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nlpodyssey/openai-agents-go/modelsettings"
+	"github.com/stretchr/testify/assert"
+	"github.com/wuhei/openai-agents-go/agents"
+)
+
+func noopInvoke(context.Context, string) (any, error) { return nil, nil }
+
+func TestResolveToolChoice(t *testing.T) {
+	t.Run("defaults to auto", func(t *testing.T) {
+		got := agents.ResolveToolChoice(agents.ToolConfig{}, 0)
+		assert.Equal(t, modelsettings.ToolChoiceAuto, got)
+	})
+
+	t.Run("any/required modes force ToolChoiceRequired", func(t *testing.T) {
+		assert.Equal(t, modelsettings.ToolChoiceRequired,
+			agents.ResolveToolChoice(agents.ToolConfig{Mode: agents.ToolChoiceAny}, 0))
+		assert.Equal(t, modelsettings.ToolChoiceRequired,
+			agents.ResolveToolChoice(agents.ToolConfig{Mode: agents.ToolChoiceRequired}, 0))
+	})
+
+	t.Run("none mode forces ToolChoiceNone regardless of AllowedToolNames", func(t *testing.T) {
+		got := agents.ResolveToolChoice(agents.ToolConfig{
+			Mode:             agents.ToolChoiceNone,
+			AllowedToolNames: []string{"only_tool"},
+		}, 0)
+		assert.Equal(t, modelsettings.ToolChoiceNone, got)
+	})
+
+	t.Run("a single allowed tool is pinned by name when Mode forces a call", func(t *testing.T) {
+		got := agents.ResolveToolChoice(agents.ToolConfig{
+			Mode:             agents.ToolChoiceAny,
+			AllowedToolNames: []string{"get_weather"},
+		}, 0)
+		assert.Equal(t, modelsettings.ToolChoiceString("get_weather"), got)
+	})
+
+	t.Run("a single allowed tool under ToolChoiceAuto stays auto, not pinned", func(t *testing.T) {
+		got := agents.ResolveToolChoice(agents.ToolConfig{
+			AllowedToolNames: []string{"get_weather"},
+		}, 0)
+		assert.Equal(t, modelsettings.ToolChoiceAuto, got)
+	})
+
+	t.Run("multiple allowed tools don't pin a name", func(t *testing.T) {
+		got := agents.ResolveToolChoice(agents.ToolConfig{
+			Mode:             agents.ToolChoiceAny,
+			AllowedToolNames: []string{"get_weather", "get_time"},
+		}, 0)
+		assert.Equal(t, modelsettings.ToolChoiceRequired, got)
+	})
+
+	t.Run("MaxToolCalls forces ToolChoiceNone once reached", func(t *testing.T) {
+		cfg := agents.ToolConfig{Mode: agents.ToolChoiceAny, MaxToolCalls: 2}
+		assert.Equal(t, modelsettings.ToolChoiceRequired, agents.ResolveToolChoice(cfg, 1))
+		assert.Equal(t, modelsettings.ToolChoiceNone, agents.ResolveToolChoice(cfg, 2))
+		assert.Equal(t, modelsettings.ToolChoiceNone, agents.ResolveToolChoice(cfg, 3))
+	})
+}
+
+type fakeNamedTool string
+
+func (f fakeNamedTool) ToolName() string { return string(f) }
+
+func TestFilterAllowedTools(t *testing.T) {
+	tools := []fakeNamedTool{"get_weather", "get_time", "send_email"}
+
+	t.Run("empty AllowedToolNames returns tools unchanged", func(t *testing.T) {
+		got := agents.FilterAllowedTools(agents.ToolConfig{}, tools)
+		assert.Equal(t, tools, got)
+	})
+
+	t.Run("restricts to the allowlist, independently of order", func(t *testing.T) {
+		got := agents.FilterAllowedTools(agents.ToolConfig{
+			AllowedToolNames: []string{"send_email", "get_weather"},
+		}, tools)
+		assert.ElementsMatch(t, []fakeNamedTool{"get_weather", "send_email"}, got)
+	})
+
+	t.Run("names not present in tools are silently ignored", func(t *testing.T) {
+		got := agents.FilterAllowedTools(agents.ToolConfig{
+			AllowedToolNames: []string{"does_not_exist"},
+		}, tools)
+		assert.Empty(t, got)
+	})
+}
+
+func TestAgent_GetAllTools_AppliesToolConfig(t *testing.T) {
+	weather := agents.FunctionTool{Name: "get_weather", OnInvokeTool: noopInvoke}
+	email := agents.FunctionTool{Name: "send_email", OnInvokeTool: noopInvoke}
+	agent := &agents.Agent{
+		Tools: []agents.Tool{weather, email},
+		ToolConfig: agents.ToolConfig{
+			AllowedToolNames: []string{"get_weather"},
+		},
+	}
+
+	got, err := agent.GetAllTools(t.Context())
+	assert := assert.New(t)
+	assert.NoError(err)
+	assert.Len(got, 1)
+	assert.Equal("get_weather", got[0].ToolName())
+}
+
+func TestAgent_NextToolChoice(t *testing.T) {
+	agent := &agents.Agent{
+		ToolConfig: agents.ToolConfig{Mode: agents.ToolChoiceAny, MaxToolCalls: 1},
+	}
+	assert.Equal(t, modelsettings.ToolChoiceRequired, agent.NextToolChoice(0))
+	assert.Equal(t, modelsettings.ToolChoiceNone, agent.NextToolChoice(1))
+}
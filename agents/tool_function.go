@@ -19,10 +19,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"strings"
 
-	"github.com/invopop/jsonschema"
-	"github.com/nlpodyssey/openai-agents-go/util"
 	"github.com/openai/openai-go/v2/packages/param"
+	"github.com/xeipuuv/gojsonschema"
 )
 
 // FunctionTool is a Tool that wraps a function.
@@ -64,6 +64,18 @@ type FunctionTool struct {
 	// enable/disable a tool based on your context/state.
 	// Default value, if omitted: true.
 	IsEnabled FunctionToolEnabler
+
+	// SkipArgumentValidation reports whether this tool's OnInvokeTool was built without
+	// gojsonschema-backed validation of the LLM-supplied arguments against
+	// ParamsJSONSchema. Use this for tools whose schema is built manually and is too
+	// dynamic for gojsonschema (e.g. recursive or generated `$ref`s it can't resolve).
+	//
+	// This field is informational: it reflects the decision a constructor already made
+	// when it built OnInvokeTool, it isn't consulted by one. NewFunctionToolWithOptions
+	// with WithSkipArgumentValidation is the way to actually skip validation; setting
+	// this field on a FunctionTool returned by SafeNewFunctionTool or NewFunctionTool
+	// has no effect, since those always validate and build OnInvokeTool accordingly.
+	SkipArgumentValidation bool
 }
 
 func (t FunctionTool) ToolName() string {
@@ -82,6 +94,90 @@ func DefaultToolErrorFunction(_ context.Context, err error) (any, error) {
 	return fmt.Sprintf("An error occurred while running the tool. Please try again. Error: %s", err), nil
 }
 
+// ToolArgumentValidationIssue describes a single constraint violation found while
+// validating an LLM tool call's arguments against a FunctionTool's ParamsJSONSchema.
+type ToolArgumentValidationIssue struct {
+	// Field is the path of the offending value within the arguments document,
+	// e.g. "(root)" for the document itself, or "city" or "items.0.sku" for a
+	// value nested in it.
+	Field string
+
+	// Constraint is the name of the violated JSON Schema keyword, e.g. "required",
+	// "enum", "pattern", or "additional_property_not_allowed".
+	Constraint string
+
+	// Description is the human-readable explanation produced by the validator.
+	Description string
+}
+
+func (i ToolArgumentValidationIssue) String() string {
+	return fmt.Sprintf("%s: %s (%s)", i.Field, i.Description, i.Constraint)
+}
+
+// ToolArgumentValidationError is returned by a FunctionTool's OnInvokeTool when the
+// LLM-supplied arguments don't satisfy ParamsJSONSchema. It is routed through
+// FailureErrorFunction like any other tool invocation error, so the LLM sees a
+// structured description of what to fix instead of an opaque JSON parse error.
+type ToolArgumentValidationError struct {
+	ToolName string
+	Issues   []ToolArgumentValidationIssue
+}
+
+func (e *ToolArgumentValidationError) Error() string {
+	parts := make([]string, len(e.Issues))
+	for i, issue := range e.Issues {
+		parts[i] = issue.String()
+	}
+	return fmt.Sprintf("tool %q received arguments that don't match its schema: %s", e.ToolName, strings.Join(parts, "; "))
+}
+
+// compileToolArgumentSchema compiles a FunctionTool's ParamsJSONSchema once, so that
+// each invocation only needs to run validation, not recompile the schema.
+func compileToolArgumentSchema(schema map[string]any) (*gojsonschema.Schema, error) {
+	if len(schema) == 0 {
+		return nil, nil
+	}
+	return gojsonschema.NewSchema(gojsonschema.NewGoLoader(schema))
+}
+
+// validateToolArguments validates the raw JSON arguments against a schema compiled by
+// compileToolArgumentSchema, returning a *ToolArgumentValidationError if they don't
+// match. Empty arguments (which LLMs send for no-arg calls) are treated as "{}", and a
+// malformed JSON document is reported as a *ToolArgumentValidationError like any other
+// constraint violation, so the model always gets a structured issue to fix instead of
+// an opaque transport error.
+func validateToolArguments(toolName string, schema *gojsonschema.Schema, arguments string) error {
+	if schema == nil {
+		return nil
+	}
+	if strings.TrimSpace(arguments) == "" {
+		arguments = "{}"
+	}
+	result, err := schema.Validate(gojsonschema.NewBytesLoader([]byte(arguments)))
+	if err != nil {
+		return &ToolArgumentValidationError{
+			ToolName: toolName,
+			Issues: []ToolArgumentValidationIssue{{
+				Field:       "(root)",
+				Constraint:  "invalid_json",
+				Description: fmt.Sprintf("arguments are not valid JSON: %s", err),
+			}},
+		}
+	}
+	if result.Valid() {
+		return nil
+	}
+	issues := make([]ToolArgumentValidationIssue, len(result.Errors()))
+	for i, resultErr := range result.Errors() {
+		issues[i] = ToolArgumentValidationIssue{
+			Field:       resultErr.Field(),
+			Constraint:  resultErr.Type(),
+			Description: resultErr.Description(),
+		}
+	}
+	return &ToolArgumentValidationError{ToolName: toolName, Issues: issues}
+}
+
 type FunctionToolEnabler interface {
 	IsEnabled(ctx context.Context, agent *Agent) (bool, error)
 }
@@ -172,33 +268,16 @@ func NewFunctionTool[T, R any](name string, description string, handler func(ctx
 }
 
 // SafeNewFunctionTool is like NewFunctionTool but returns an error instead of panicking.
+//
+// Schema generation goes through the package's default SchemaReflector (InvopopReflector
+// unless changed via SetDefaultSchemaReflector), same as NewFunctionToolWithOptions
+// without an explicit WithSchemaReflector. For per-call control over the reflector or a
+// schema transform, use NewFunctionToolWithOptions instead.
 func SafeNewFunctionTool[T, R any](name string, description string, handler func(ctx context.Context, args T) (R, error)) (FunctionTool, error) {
-	reflector := &jsonschema.Reflector{
-		ExpandedStruct:             true,
-		RequiredFromJSONSchemaTags: false,
-		AllowAdditionalProperties:  false,
-	}
-
 	var zero T
-	var schema *jsonschema.Schema
-	t := reflect.TypeOf(zero)
-	if t.Kind() == reflect.Struct && t.Name() == "" && t.NumField() == 0 {
-		// Avoid panic in jsonschema when reflecting an anonymous empty struct
-		schema = &jsonschema.Schema{
-			Version:    jsonschema.Version,
-			Type:       "object",
-			Properties: jsonschema.NewProperties(),
-		}
-		if !reflector.AllowAdditionalProperties {
-			schema.AdditionalProperties = jsonschema.FalseSchema
-		}
-	} else {
-		schema = reflector.Reflect(&zero)
-	}
-
-	schemaMap, err := util.JSONMap(schema)
+	schemaMap, err := defaultSchemaReflector.Reflect(reflect.TypeOf(zero))
 	if err != nil {
-		return FunctionTool{}, fmt.Errorf("failed to transform function tool jsonschema.Schema to map: %w", err)
+		return FunctionTool{}, fmt.Errorf("failed to reflect function tool json schema: %w", err)
 	}
 
 	schemaMap, err = EnsureStrictJSONSchema(schemaMap)
@@ -211,12 +290,20 @@ func SafeNewFunctionTool[T, R any](name string, description string, handler func
 	// 	schemaMap["description"] = description
 	// }
 
+	compiledSchema, err := compileToolArgumentSchema(schemaMap)
+	if err != nil {
+		return FunctionTool{}, fmt.Errorf("failed to compile function tool json schema for validation: %w", err)
+	}
+
 	return FunctionTool{
 		Name:             name,
 		ParamsJSONSchema: schemaMap,
 		StrictJSONSchema: param.NewOpt(true),
 		Description:      description,
 		OnInvokeTool: func(ctx context.Context, arguments string) (any, error) {
+			if err := validateToolArguments(name, compiledSchema, arguments); err != nil {
+				return nil, err
+			}
 			var args T
 			if err := json.Unmarshal([]byte(arguments), &args); err != nil {
 				return nil, fmt.Errorf("failed to parse arguments: %w", err)
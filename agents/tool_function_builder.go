@@ -0,0 +1,126 @@
+// Copyright 2025 The NLP Odyssey Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agents
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/openai/openai-go/v2/packages/param"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// FunctionToolOption configures NewFunctionToolWithOptions.
+type FunctionToolOption func(*functionToolOptions)
+
+type functionToolOptions struct {
+	reflector              SchemaReflector
+	schemaTransform        func(map[string]any) map[string]any
+	skipArgumentValidation bool
+}
+
+// WithSchemaReflector overrides the SchemaReflector used to generate the tool's
+// ParamsJSONSchema. Defaults to the package's current default reflector; see
+// SetDefaultSchemaReflector.
+func WithSchemaReflector(reflector SchemaReflector) FunctionToolOption {
+	return func(o *functionToolOptions) { o.reflector = reflector }
+}
+
+// WithSchemaTransform registers a function that rewrites the reflected schema before
+// EnsureStrictJSONSchema runs, e.g. to inject "x-*" vendor extensions, rename fields,
+// or strip "$defs" for models that don't tolerate them. Transforms from multiple
+// WithSchemaTransform calls run in the order they were passed to
+// NewFunctionToolWithOptions.
+func WithSchemaTransform(transform func(map[string]any) map[string]any) FunctionToolOption {
+	return func(o *functionToolOptions) {
+		if transform == nil {
+			return
+		}
+		previous := o.schemaTransform
+		o.schemaTransform = func(schema map[string]any) map[string]any {
+			if previous != nil {
+				schema = previous(schema)
+			}
+			return transform(schema)
+		}
+	}
+}
+
+// WithSkipArgumentValidation opts this tool out of the gojsonschema-backed argument
+// validation NewFunctionToolWithOptions performs by default. Prefer this over setting
+// FunctionTool.SkipArgumentValidation on the returned value: this option is read
+// before OnInvokeTool is built, so it actually takes effect.
+func WithSkipArgumentValidation() FunctionToolOption {
+	return func(o *functionToolOptions) { o.skipArgumentValidation = true }
+}
+
+// NewFunctionToolWithOptions is like NewFunctionTool, but lets callers override how
+// the parameter schema is generated and post-processed, via WithSchemaReflector and
+// WithSchemaTransform, instead of being locked into the package's default
+// SchemaReflector that SafeNewFunctionTool always uses.
+func NewFunctionToolWithOptions[T, R any](
+	name string,
+	description string,
+	handler func(ctx context.Context, args T) (R, error),
+	opts ...FunctionToolOption,
+) (FunctionTool, error) {
+	options := functionToolOptions{reflector: defaultSchemaReflector}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	var zero T
+	schemaMap, err := options.reflector.Reflect(reflect.TypeOf(zero))
+	if err != nil {
+		return FunctionTool{}, fmt.Errorf("failed to reflect function tool json schema: %w", err)
+	}
+
+	if options.schemaTransform != nil {
+		schemaMap = options.schemaTransform(schemaMap)
+	}
+
+	schemaMap, err = EnsureStrictJSONSchema(schemaMap)
+	if err != nil {
+		return FunctionTool{}, fmt.Errorf("failed to ensure strictness of function tool json schema: %w", err)
+	}
+
+	var compiledSchema *gojsonschema.Schema
+	if !options.skipArgumentValidation {
+		compiledSchema, err = compileToolArgumentSchema(schemaMap)
+		if err != nil {
+			return FunctionTool{}, fmt.Errorf("failed to compile function tool json schema for validation: %w", err)
+		}
+	}
+
+	return FunctionTool{
+		Name:                   name,
+		Description:            description,
+		ParamsJSONSchema:       schemaMap,
+		StrictJSONSchema:       param.NewOpt(true),
+		SkipArgumentValidation: options.skipArgumentValidation,
+		OnInvokeTool: func(ctx context.Context, arguments string) (any, error) {
+			if err := validateToolArguments(name, compiledSchema, arguments); err != nil {
+				return nil, err
+			}
+			var args T
+			if err := json.Unmarshal([]byte(arguments), &args); err != nil {
+				return nil, fmt.Errorf("failed to parse arguments: %w", err)
+			}
+			return handler(ctx, args)
+		},
+	}, nil
+}
@@ -0,0 +1,97 @@
+// Copyright 2025 The NLP Odyssey Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agents_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wuhei/openai-agents-go/agents"
+)
+
+type validationArgs struct {
+	City string `json:"city"`
+}
+
+func echoCity(_ context.Context, args validationArgs) (string, error) {
+	return args.City, nil
+}
+
+func TestSafeNewFunctionTool_ArgumentValidation(t *testing.T) {
+	tool, err := agents.SafeNewFunctionTool("echo_city", "", echoCity)
+	require.NoError(t, err)
+
+	t.Run("valid arguments pass through", func(t *testing.T) {
+		result, err := tool.OnInvokeTool(t.Context(), `{"city":"Tokyo"}`)
+		require.NoError(t, err)
+		assert.Equal(t, "Tokyo", result)
+	})
+
+	t.Run("arguments missing a required field are rejected", func(t *testing.T) {
+		_, err := tool.OnInvokeTool(t.Context(), `{}`)
+		require.Error(t, err)
+		var validationErr *agents.ToolArgumentValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "echo_city", validationErr.ToolName)
+		require.Len(t, validationErr.Issues, 1)
+		assert.Equal(t, "required", validationErr.Issues[0].Constraint)
+	})
+
+	t.Run("empty arguments are treated as no arguments, not a parse error", func(t *testing.T) {
+		_, err := tool.OnInvokeTool(t.Context(), "")
+		require.Error(t, err)
+		var validationErr *agents.ToolArgumentValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Len(t, validationErr.Issues, 1)
+		assert.Equal(t, "required", validationErr.Issues[0].Constraint)
+	})
+
+	t.Run("malformed JSON is reported as a structured validation issue", func(t *testing.T) {
+		_, err := tool.OnInvokeTool(t.Context(), `{not json`)
+		require.Error(t, err)
+		var validationErr *agents.ToolArgumentValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Len(t, validationErr.Issues, 1)
+		assert.Equal(t, "invalid_json", validationErr.Issues[0].Constraint)
+	})
+}
+
+func TestNewFunctionToolWithOptions_SkipArgumentValidation(t *testing.T) {
+	tool, err := agents.NewFunctionToolWithOptions("echo_city", "", echoCity,
+		agents.WithSkipArgumentValidation())
+	require.NoError(t, err)
+	assert.True(t, tool.SkipArgumentValidation)
+
+	// Arguments that would fail schema validation are passed straight to the handler,
+	// since validation was skipped when OnInvokeTool was built.
+	result, err := tool.OnInvokeTool(t.Context(), `{}`)
+	require.NoError(t, err)
+	assert.Equal(t, "", result)
+}
+
+func TestSafeNewFunctionTool_SkipArgumentValidationFieldHasNoEffect(t *testing.T) {
+	tool, err := agents.SafeNewFunctionTool("echo_city", "", echoCity)
+	require.NoError(t, err)
+
+	// Setting the field after construction doesn't change OnInvokeTool, which was
+	// already built to always validate - see the field's doc comment.
+	tool.SkipArgumentValidation = true
+	_, err = tool.OnInvokeTool(t.Context(), `{}`)
+	require.Error(t, err)
+	var validationErr *agents.ToolArgumentValidationError
+	require.ErrorAs(t, err, &validationErr)
+}
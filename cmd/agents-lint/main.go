@@ -0,0 +1,109 @@
+// Copyright 2025 The NLP Odyssey Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command agents-lint checks a set of FunctionTool schemas for strict-mode
+// conformance and other pitfalls (see agents.LintTools), so issues are caught at build
+// time in CI rather than at run time against the model.
+//
+// Tools are read from a JSON file: an array of objects with "name", "description" and
+// "params_json_schema" fields, matching FunctionTool's exported data. This release
+// doesn't load and introspect an arbitrary Go package's tool factory; for that, write
+// a small program that imports your package, builds your []agents.FunctionTool, and
+// calls agents.LintTools directly.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/wuhei/openai-agents-go/agents"
+)
+
+// toolManifestEntry is the on-disk shape of one tool in the -tools JSON file.
+type toolManifestEntry struct {
+	Name             string         `json:"name"`
+	Description      string         `json:"description"`
+	ParamsJSONSchema map[string]any `json:"params_json_schema"`
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout, stderr *os.File) int {
+	fs := flag.NewFlagSet("agents-lint", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	toolsPath := fs.String("tools", "", "path to a JSON file listing tools to lint (required)")
+	format := fs.String("format", "text", `output format: "text" or "json"`)
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	if *toolsPath == "" {
+		fmt.Fprintln(stderr, "agents-lint: -tools is required")
+		fs.Usage()
+		return 2
+	}
+
+	tools, err := loadToolManifest(*toolsPath)
+	if err != nil {
+		fmt.Fprintf(stderr, "agents-lint: %v\n", err)
+		return 2
+	}
+
+	issues := agents.LintTools(tools)
+
+	switch *format {
+	case "json":
+		if err := json.NewEncoder(stdout).Encode(issues); err != nil {
+			fmt.Fprintf(stderr, "agents-lint: %v\n", err)
+			return 2
+		}
+	default:
+		for _, issue := range issues {
+			fmt.Fprintln(stdout, issue.String())
+		}
+	}
+
+	for _, issue := range issues {
+		if issue.Severity == agents.LintError {
+			return 1
+		}
+	}
+	return 0
+}
+
+func loadToolManifest(path string) ([]agents.FunctionTool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %q: %w", path, err)
+	}
+
+	var entries []toolManifestEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to parse %q: %w", path, err)
+	}
+
+	tools := make([]agents.FunctionTool, len(entries))
+	for i, entry := range entries {
+		tools[i] = agents.FunctionTool{
+			Name:             entry.Name,
+			Description:      entry.Description,
+			ParamsJSONSchema: entry.ParamsJSONSchema,
+		}
+	}
+	return tools, nil
+}